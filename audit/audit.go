@@ -0,0 +1,32 @@
+// Package audit records an append-only trail of suppression-list
+// mutations: who did what to which address, and whether it succeeded.
+package audit
+
+import "time"
+
+// Entry is a single audited mutation.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Action    string    `json:"action"` // e.g. "remove", "add", "import"
+	Email     string    `json:"email"`
+	Reason    string    `json:"reason,omitempty"`
+	Success   bool      `json:"success"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Query filters Entries by time range and, optionally, email.
+type Query struct {
+	From  time.Time
+	To    time.Time
+	Email string
+}
+
+// Logger is an append-only audit trail. JSONLLogger and SQLiteLogger are
+// the implementations shipped with this package, selected via
+// NewLoggerFromEnv; additional backends can be added later by
+// implementing this interface.
+type Logger interface {
+	Append(e Entry) error
+	Query(q Query) ([]Entry, error)
+}
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewLoggerFromEnv builds a Logger based on AUDIT_BACKEND ("jsonl" or
+// "sqlite"; defaults to "jsonl"). The JSONL backend reads its path from
+// AUDIT_LOG_PATH (default "audit.jsonl"); the SQLite backend reads its
+// path from AUDIT_DB_PATH (default "audit.db").
+func NewLoggerFromEnv() (Logger, error) {
+	switch os.Getenv("AUDIT_BACKEND") {
+	case "sqlite":
+		path := os.Getenv("AUDIT_DB_PATH")
+		if path == "" {
+			path = "audit.db"
+		}
+		return NewSQLiteLogger(path)
+	case "", "jsonl":
+		path := os.Getenv("AUDIT_LOG_PATH")
+		if path == "" {
+			path = "audit.jsonl"
+		}
+		return NewJSONLLogger(path)
+	default:
+		return nil, fmt.Errorf("audit: unknown AUDIT_BACKEND %q", os.Getenv("AUDIT_BACKEND"))
+	}
+}
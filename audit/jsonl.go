@@ -0,0 +1,76 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// JSONLLogger appends one JSON object per line to a file. Query reads
+// and filters the whole file, which is fine at the scale an admin tool
+// like this one operates at.
+type JSONLLogger struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewJSONLLogger returns a JSONLLogger writing to path, creating it if
+// it does not already exist.
+func NewJSONLLogger(path string) (*JSONLLogger, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	return &JSONLLogger{path: path}, nil
+}
+
+func (l *JSONLLogger) Append(e Entry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return json.NewEncoder(f).Encode(e)
+}
+
+func (l *JSONLLogger) Query(q Query) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var out []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, err
+		}
+		if !q.From.IsZero() && e.Timestamp.Before(q.From) {
+			continue
+		}
+		if !q.To.IsZero() && e.Timestamp.After(q.To) {
+			continue
+		}
+		if q.Email != "" && e.Email != q.Email {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, scanner.Err()
+}
@@ -0,0 +1,51 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJSONLLoggerAppendAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewJSONLLogger(path)
+	if err != nil {
+		t.Fatalf("NewJSONLLogger returned error: %v", err)
+	}
+
+	now := time.Now()
+	entries := []Entry{
+		{Timestamp: now.Add(-time.Hour), User: "alice", Action: "remove", Email: "a@example.com", Success: true},
+		{Timestamp: now, User: "bob", Action: "add", Email: "b@example.com", Reason: "BOUNCE", Success: true},
+		{Timestamp: now.Add(time.Minute), User: "alice", Action: "remove", Email: "a@example.com", Success: false, Error: "boom"},
+	}
+	for _, e := range entries {
+		if err := logger.Append(e); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	got, err := logger.Query(Query{})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(got))
+	}
+
+	got, err = logger.Query(Query{Email: "a@example.com"})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries for a@example.com, got %d", len(got))
+	}
+
+	got, err = logger.Query(Query{From: now})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries from now, got %d", len(got))
+	}
+}
@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"database/sql"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// SQLiteLogger appends entries to a SQLite database instead of a flat
+// JSONL file, for deployments that want queryable storage without
+// running a separate database server.
+type SQLiteLogger struct {
+	db *sql.DB
+}
+
+// NewSQLiteLogger opens (and, if needed, creates) a SQLite database at
+// path and ensures its schema exists.
+func NewSQLiteLogger(path string) (*SQLiteLogger, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	const schema = `CREATE TABLE IF NOT EXISTS audit_entries (
+		timestamp INTEGER NOT NULL,
+		user      TEXT NOT NULL,
+		action    TEXT NOT NULL,
+		email     TEXT NOT NULL,
+		reason    TEXT,
+		success   INTEGER NOT NULL,
+		error     TEXT
+	)`
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &SQLiteLogger{db: db}, nil
+}
+
+func (l *SQLiteLogger) Append(e Entry) error {
+	_, err := l.db.Exec(
+		`INSERT INTO audit_entries (timestamp, user, action, email, reason, success, error) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.Timestamp.UnixNano(), e.User, e.Action, e.Email, e.Reason, e.Success, e.Error,
+	)
+	return err
+}
+
+func (l *SQLiteLogger) Query(q Query) ([]Entry, error) {
+	query := `SELECT timestamp, user, action, email, reason, success, error FROM audit_entries WHERE 1=1`
+	var args []any
+	if !q.From.IsZero() {
+		query += ` AND timestamp >= ?`
+		args = append(args, q.From.UnixNano())
+	}
+	if !q.To.IsZero() {
+		query += ` AND timestamp <= ?`
+		args = append(args, q.To.UnixNano())
+	}
+	if q.Email != "" {
+		query += ` AND email = ?`
+		args = append(args, q.Email)
+	}
+	query += ` ORDER BY timestamp`
+
+	rows, err := l.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Entry
+	for rows.Next() {
+		var e Entry
+		var timestamp int64
+		if err := rows.Scan(&timestamp, &e.User, &e.Action, &e.Email, &e.Reason, &e.Success, &e.Error); err != nil {
+			return nil, err
+		}
+		e.Timestamp = time.Unix(0, timestamp).UTC()
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSQLiteLoggerAppendAndQuery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.db")
+	logger, err := NewSQLiteLogger(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteLogger returned error: %v", err)
+	}
+
+	now := time.Now()
+	entries := []Entry{
+		{Timestamp: now.Add(-time.Hour), User: "alice", Action: "remove", Email: "a@example.com", Success: true},
+		{Timestamp: now, User: "bob", Action: "add", Email: "b@example.com", Reason: "BOUNCE", Success: true},
+		{Timestamp: now.Add(time.Minute), User: "alice", Action: "remove", Email: "a@example.com", Success: false, Error: "boom"},
+	}
+	for _, e := range entries {
+		if err := logger.Append(e); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	got, err := logger.Query(Query{})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(got))
+	}
+
+	got, err = logger.Query(Query{Email: "a@example.com"})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries for a@example.com, got %d", len(got))
+	}
+
+	got, err = logger.Query(Query{From: now})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries from now, got %d", len(got))
+	}
+}
+
+// TestSQLiteLoggerOrdersByTimeNotLexically guards against storing
+// timestamps as RFC3339Nano text: that format drops the fractional part
+// entirely when it is zero, so a boundary with no fraction sorts after
+// an earlier-second entry that happens to have one (e.g. "...:00Z" >
+// "...:00.123Z" lexically, despite being chronologically earlier).
+func TestSQLiteLoggerOrdersByTimeNotLexically(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.db")
+	logger, err := NewSQLiteLogger(path)
+	if err != nil {
+		t.Fatalf("NewSQLiteLogger returned error: %v", err)
+	}
+
+	boundary := time.Date(2026, 7, 26, 0, 0, 0, 0, time.UTC)
+	earlier := boundary.Add(-time.Minute).Add(123 * time.Millisecond)
+
+	for _, e := range []Entry{
+		{Timestamp: earlier, User: "alice", Action: "remove", Email: "a@example.com", Success: true},
+		{Timestamp: boundary, User: "bob", Action: "remove", Email: "b@example.com", Success: true},
+	} {
+		if err := logger.Append(e); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	got, err := logger.Query(Query{From: boundary})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].Email != "b@example.com" {
+		t.Fatalf("expected only the boundary entry from=%s, got %+v", boundary, got)
+	}
+
+	got, err = logger.Query(Query{To: boundary.Add(-time.Second)})
+	if err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+	if len(got) != 1 || got[0].Email != "a@example.com" {
+		t.Fatalf("expected only the earlier entry to=%s, got %+v", boundary.Add(-time.Second), got)
+	}
+}
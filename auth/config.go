@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// NewStoreFromEnv builds a Store based on AUTH_BACKEND ("memory", "file",
+// or "redis"; defaults to "memory"). The file backend reads its path
+// from AUTH_FILE_PATH (default "sessions.json"); the redis backend reads
+// its address from AUTH_REDIS_ADDR (default "localhost:6379").
+func NewStoreFromEnv() (Store, error) {
+	switch os.Getenv("AUTH_BACKEND") {
+	case "file":
+		path := os.Getenv("AUTH_FILE_PATH")
+		if path == "" {
+			path = "sessions.json"
+		}
+		return NewFileStore(path)
+	case "redis":
+		addr := os.Getenv("AUTH_REDIS_ADDR")
+		if addr == "" {
+			addr = "localhost:6379"
+		}
+		return NewRedisStore(redis.NewClient(&redis.Options{Addr: addr})), nil
+	case "", "memory":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("auth: unknown AUTH_BACKEND %q", os.Getenv("AUTH_BACKEND"))
+	}
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileStore is a Store backed by a JSON file, rewritten on every
+// mutation. It survives restarts but, unlike RedisStore, isn't suitable
+// for sharing sessions across multiple server instances.
+type FileStore struct {
+	path string
+
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewFileStore loads sessions from path, creating an empty store if the
+// file does not yet exist.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{path: path, sessions: make(map[string]Session)}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return s, nil
+	}
+	if err := json.Unmarshal(data, &s.sessions); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// save persists the current session map. Callers must hold s.mu.
+func (s *FileStore) save() error {
+	data, err := json.Marshal(s.sessions)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
+
+func (s *FileStore) Create(ctx context.Context, user string) (Session, error) {
+	token, err := newToken()
+	if err != nil {
+		return Session{}, err
+	}
+	sess := Session{Token: token, User: user, ExpiresAt: time.Now().Add(SessionTTL)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[token] = sess
+	if err := s.save(); err != nil {
+		delete(s.sessions, token)
+		return Session{}, err
+	}
+	return sess, nil
+}
+
+func (s *FileStore) Get(ctx context.Context, token string) (Session, error) {
+	s.mu.Lock()
+	sess, ok := s.sessions[token]
+	s.mu.Unlock()
+	if !ok || time.Now().After(sess.ExpiresAt) {
+		return Session{}, ErrSessionNotFound
+	}
+	return sess, nil
+}
+
+func (s *FileStore) Delete(ctx context.Context, token string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, token)
+	return s.save()
+}
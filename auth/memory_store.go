@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore is an in-memory Store. Sessions are lost on restart; use
+// FileStore or RedisStore when that matters.
+type MemoryStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: make(map[string]Session)}
+}
+
+func (s *MemoryStore) Create(ctx context.Context, user string) (Session, error) {
+	token, err := newToken()
+	if err != nil {
+		return Session{}, err
+	}
+	sess := Session{Token: token, User: user, ExpiresAt: time.Now().Add(SessionTTL)}
+
+	s.mu.Lock()
+	s.sessions[token] = sess
+	s.mu.Unlock()
+	return sess, nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, token string) (Session, error) {
+	s.mu.Lock()
+	sess, ok := s.sessions[token]
+	s.mu.Unlock()
+	if !ok || time.Now().After(sess.ExpiresAt) {
+		return Session{}, ErrSessionNotFound
+	}
+	return sess, nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, token string) error {
+	s.mu.Lock()
+	delete(s.sessions, token)
+	s.mu.Unlock()
+	return nil
+}
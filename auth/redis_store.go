@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStore is a Store backed by Redis, suitable for sharing sessions
+// across multiple server instances. Keys are namespaced under
+// "suppression-gui:session:" and expire via Redis TTL.
+type RedisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore returns a RedisStore using client.
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+func redisKey(token string) string {
+	return "suppression-gui:session:" + token
+}
+
+func (s *RedisStore) Create(ctx context.Context, user string) (Session, error) {
+	token, err := newToken()
+	if err != nil {
+		return Session{}, err
+	}
+	sess := Session{Token: token, User: user, ExpiresAt: time.Now().Add(SessionTTL)}
+
+	data, err := json.Marshal(sess)
+	if err != nil {
+		return Session{}, err
+	}
+	if err := s.client.Set(ctx, redisKey(token), data, SessionTTL).Err(); err != nil {
+		return Session{}, err
+	}
+	return sess, nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, token string) (Session, error) {
+	data, err := s.client.Get(ctx, redisKey(token)).Bytes()
+	if err == redis.Nil {
+		return Session{}, ErrSessionNotFound
+	}
+	if err != nil {
+		return Session{}, err
+	}
+	var sess Session
+	if err := json.Unmarshal(data, &sess); err != nil {
+		return Session{}, err
+	}
+	if time.Now().After(sess.ExpiresAt) {
+		return Session{}, ErrSessionNotFound
+	}
+	return sess, nil
+}
+
+func (s *RedisStore) Delete(ctx context.Context, token string) error {
+	return s.client.Del(ctx, redisKey(token)).Err()
+}
@@ -0,0 +1,94 @@
+// Package auth provides cookie-based session authentication and CSRF
+// protection for the suppression-list admin UI. Every handler that
+// mutates SES state should sit behind Middleware, and every mutating POST
+// should sit behind CSRFMiddleware.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const (
+	// CookieName is the session cookie set on successful login.
+	CookieName = "suppression_session"
+	// SessionTTL is how long a session stays valid after creation.
+	SessionTTL = 12 * time.Hour
+)
+
+// ErrSessionNotFound is returned by Store.Get when the token is unknown
+// or has expired.
+var ErrSessionNotFound = errors.New("auth: session not found")
+
+// Session is a single authenticated session.
+type Session struct {
+	Token     string
+	User      string
+	ExpiresAt time.Time
+}
+
+// Store persists sessions. Implementations: MemoryStore, FileStore,
+// RedisStore, selected via env at startup (see NewStoreFromEnv).
+type Store interface {
+	Create(ctx context.Context, user string) (Session, error)
+	Get(ctx context.Context, token string) (Session, error)
+	Delete(ctx context.Context, token string) error
+}
+
+// newToken returns a random, URL-safe session token.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+type contextKey string
+
+const userContextKey contextKey = "auth.user"
+
+// UserFromContext returns the authenticated user for the request, if any.
+func UserFromContext(ctx context.Context) (string, bool) {
+	u, ok := ctx.Value(userContextKey).(string)
+	return u, ok
+}
+
+// Middleware rejects any request without a valid session cookie with a
+// 401, otherwise attaches the authenticated user to the request context.
+// Use this for API endpoints; use MiddlewareRedirect for pages a browser
+// navigates to directly.
+func Middleware(store Store, next http.Handler) http.Handler {
+	return authenticate(store, next, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "login required", http.StatusUnauthorized)
+	})
+}
+
+// MiddlewareRedirect behaves like Middleware but sends an unauthenticated
+// browser to loginPath instead of returning a 401.
+func MiddlewareRedirect(store Store, loginPath string, next http.Handler) http.Handler {
+	return authenticate(store, next, func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, loginPath, http.StatusFound)
+	})
+}
+
+func authenticate(store Store, next http.Handler, onFail http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(CookieName)
+		if err != nil {
+			onFail(w, r)
+			return
+		}
+		sess, err := store.Get(r.Context(), cookie.Value)
+		if err != nil {
+			onFail(w, r)
+			return
+		}
+		ctx := context.WithValue(r.Context(), userContextKey, sess.User)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
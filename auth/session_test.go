@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreCreateAndGet(t *testing.T) {
+	store := NewMemoryStore()
+	sess, err := store.Create(context.Background(), "alice")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	got, err := store.Get(context.Background(), sess.Token)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got.User != "alice" {
+		t.Fatalf("expected user alice, got %q", got.User)
+	}
+}
+
+func TestMemoryStoreExpiredSession(t *testing.T) {
+	store := NewMemoryStore()
+	sess, _ := store.Create(context.Background(), "alice")
+	store.mu.Lock()
+	expired := store.sessions[sess.Token]
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	store.sessions[sess.Token] = expired
+	store.mu.Unlock()
+
+	if _, err := store.Get(context.Background(), sess.Token); err != ErrSessionNotFound {
+		t.Fatalf("expected ErrSessionNotFound, got %v", err)
+	}
+}
+
+func TestFileStorePersistsAcrossInstances(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.json")
+
+	store1, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore returned error: %v", err)
+	}
+	sess, err := store1.Create(context.Background(), "bob")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	store2, err := NewFileStore(path)
+	if err != nil {
+		t.Fatalf("NewFileStore returned error: %v", err)
+	}
+	got, err := store2.Get(context.Background(), sess.Token)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if got.User != "bob" {
+		t.Fatalf("expected user bob, got %q", got.User)
+	}
+}
+
+func TestMiddlewareRejectsMissingCookie(t *testing.T) {
+	store := NewMemoryStore()
+	handler := Middleware(store, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/list", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestMiddlewareAcceptsValidSession(t *testing.T) {
+	store := NewMemoryStore()
+	sess, _ := store.Create(context.Background(), "alice")
+
+	var gotUser string
+	handler := Middleware(store, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUser, _ = UserFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/list", nil)
+	req.AddCookie(&http.Cookie{Name: CookieName, Value: sess.Token})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if gotUser != "alice" {
+		t.Fatalf("expected user alice in context, got %q", gotUser)
+	}
+}
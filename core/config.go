@@ -0,0 +1,46 @@
+package core
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultTimeout     = 30 * time.Second
+	defaultConcurrency = 8
+	defaultRateLimit   = 10 // requests per second
+)
+
+// timeoutFromEnv returns the per-request SES timeout, configurable via
+// SES_REQUEST_TIMEOUT (a duration string such as "45s"). Defaults to 30s.
+func timeoutFromEnv() time.Duration {
+	if v := os.Getenv("SES_REQUEST_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
+	}
+	return defaultTimeout
+}
+
+// concurrencyFromEnv returns the worker pool size for Remove, configurable
+// via SES_DELETE_CONCURRENCY. Defaults to 8.
+func concurrencyFromEnv() int {
+	if v := os.Getenv("SES_DELETE_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			return n
+		}
+	}
+	return defaultConcurrency
+}
+
+// rateLimitFromEnv returns the shared SES request rate limit (requests per
+// second), configurable via SES_RATE_LIMIT. Defaults to 10.
+func rateLimitFromEnv() float64 {
+	if v := os.Getenv("SES_RATE_LIMIT"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f > 0 {
+			return f
+		}
+	}
+	return defaultRateLimit
+}
@@ -0,0 +1,296 @@
+// Package core implements suppression-list operations against AWS SES,
+// independent of any transport. HTTP handlers (or, eventually, other
+// callers such as scheduled syncs or a Kafka publisher) should depend on
+// this package rather than talking to the AWS SDK directly.
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sesv2 "github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"golang.org/x/time/rate"
+
+	"github.com/brookwarren/suppression-gui/metrics"
+)
+
+// SESAPI is the subset of the sesv2 client this package depends on. It
+// exists so tests can inject a fake client instead of hitting AWS.
+type SESAPI interface {
+	ListSuppressedDestinations(ctx context.Context, params *sesv2.ListSuppressedDestinationsInput, optFns ...func(*sesv2.Options)) (*sesv2.ListSuppressedDestinationsOutput, error)
+	DeleteSuppressedDestination(ctx context.Context, params *sesv2.DeleteSuppressedDestinationInput, optFns ...func(*sesv2.Options)) (*sesv2.DeleteSuppressedDestinationOutput, error)
+	PutSuppressedDestination(ctx context.Context, params *sesv2.PutSuppressedDestinationInput, optFns ...func(*sesv2.Options)) (*sesv2.PutSuppressedDestinationOutput, error)
+}
+
+// Entry is a single suppression-list record.
+type Entry struct {
+	EmailAddress   string
+	Reason         types.SuppressionListReason
+	LastUpdateTime time.Time
+}
+
+// Result is the outcome of adding or removing a single entry.
+type Result struct {
+	EmailAddress string
+	Err          error
+}
+
+// Client holds the suppression-list cache and the SES client used to
+// keep it in sync.
+type Client struct {
+	api SESAPI
+
+	timeout     time.Duration // per-request deadline for SES calls
+	concurrency int           // worker pool size for Remove
+	limiter     *rate.Limiter // shared SES request rate limit
+
+	mu    sync.RWMutex
+	list  []Entry          // sorted by EmailAddress, case-insensitive
+	index map[string]Entry // lowercase email -> entry
+}
+
+// New returns a Client backed by api. Callers typically pass an
+// *sesv2.Client; tests pass a fake implementing SESAPI. Request timeout,
+// delete concurrency, and rate limit are configurable via
+// SES_REQUEST_TIMEOUT, SES_DELETE_CONCURRENCY, and SES_RATE_LIMIT.
+func New(api SESAPI) *Client {
+	concurrency := concurrencyFromEnv()
+	return &Client{
+		api:         api,
+		timeout:     timeoutFromEnv(),
+		concurrency: concurrency,
+		limiter:     rate.NewLimiter(rate.Limit(rateLimitFromEnv()), concurrency),
+		index:       make(map[string]Entry),
+	}
+}
+
+// Refresh re-lists every suppressed destination in the account and
+// replaces the in-memory cache wholesale.
+func (c *Client) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var (
+		entries []Entry
+		token   *string
+	)
+
+	for {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+
+		var out *sesv2.ListSuppressedDestinationsOutput
+		start := time.Now()
+		err := withRetry(ctx, func() error {
+			var apiErr error
+			out, apiErr = c.api.ListSuppressedDestinations(ctx, &sesv2.ListSuppressedDestinationsInput{
+				NextToken: token,
+				PageSize:  aws.Int32(1000),
+			})
+			return apiErr
+		})
+		metrics.SESListDuration.Observe(time.Since(start).Seconds())
+		if err != nil {
+			return err
+		}
+
+		for _, d := range out.SuppressedDestinationSummaries {
+			entries = append(entries, Entry{
+				EmailAddress:   aws.ToString(d.EmailAddress),
+				Reason:         d.Reason,
+				LastUpdateTime: aws.ToTime(d.LastUpdateTime),
+			})
+		}
+
+		if out.NextToken == nil {
+			break
+		}
+		token = out.NextToken
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return strings.ToLower(entries[i].EmailAddress) < strings.ToLower(entries[j].EmailAddress)
+	})
+
+	idx := make(map[string]Entry, len(entries))
+	for _, e := range entries {
+		idx[strings.ToLower(e.EmailAddress)] = e
+	}
+
+	c.mu.Lock()
+	c.list, c.index = entries, idx
+	c.mu.Unlock()
+
+	metrics.SESListEntries.Set(float64(len(entries)))
+	metrics.SuppressionListSize.Set(float64(len(entries)))
+	return nil
+}
+
+// List returns a snapshot of the cached suppression list.
+func (c *Client) List(ctx context.Context) ([]Entry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	out := make([]Entry, len(c.list))
+	copy(out, c.list)
+	return out, nil
+}
+
+// Lookup returns the cached entry for addr, if present.
+func (c *Client) Lookup(ctx context.Context, addr string) (Entry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.index[strings.ToLower(addr)]
+	return e, ok
+}
+
+// Remove deletes each of addrs from SES and from the cache. Addresses not
+// present in the cache are reported as errors rather than attempted
+// against SES. Deletes run concurrently across a bounded worker pool
+// (SES_DELETE_CONCURRENCY workers) and share a rate limiter so SES
+// account-level TPS limits are not exceeded; results are nonetheless
+// returned in the same order as addrs.
+func (c *Client) Remove(ctx context.Context, addrs []string) ([]Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]Result, len(addrs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < c.concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = c.removeOne(ctx, addrs[i])
+			}
+		}()
+	}
+
+	for i := range addrs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// removeOne deletes a single address from SES and from the cache.
+func (c *Client) removeOne(ctx context.Context, addr string) Result {
+	key := strings.ToLower(addr)
+	c.mu.RLock()
+	entry, ok := c.index[key]
+	c.mu.RUnlock()
+	if !ok {
+		return Result{EmailAddress: addr, Err: ErrNotFound}
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return Result{EmailAddress: addr, Err: err}
+	}
+
+	err := withRetry(ctx, func() error {
+		_, apiErr := c.api.DeleteSuppressedDestination(ctx, &sesv2.DeleteSuppressedDestinationInput{
+			EmailAddress: aws.String(entry.EmailAddress),
+		})
+		return apiErr
+	})
+	if err != nil {
+		metrics.SESDeleteTotal.WithLabelValues("error").Inc()
+		return Result{EmailAddress: addr, Err: err}
+	}
+
+	c.remove(key, entry.EmailAddress)
+	metrics.SESDeleteTotal.WithLabelValues("success").Inc()
+	return Result{EmailAddress: entry.EmailAddress}
+}
+
+// Add puts each of entries into SES and into the cache. Results are
+// returned in the same order as entries. Like Remove, each call is rate
+// limited and retried with backoff on throttling so bulk imports and
+// authoritative source syncs don't exceed SES account-level TPS limits.
+func (c *Client) Add(ctx context.Context, entries []Entry) ([]Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	results := make([]Result, len(entries))
+	for i, entry := range entries {
+		results[i] = c.addOne(ctx, entry)
+	}
+	return results, nil
+}
+
+// addOne puts a single entry into SES and into the cache.
+func (c *Client) addOne(ctx context.Context, entry Entry) Result {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return Result{EmailAddress: entry.EmailAddress, Err: err}
+	}
+
+	err := withRetry(ctx, func() error {
+		_, apiErr := c.api.PutSuppressedDestination(ctx, &sesv2.PutSuppressedDestinationInput{
+			EmailAddress: aws.String(entry.EmailAddress),
+			Reason:       entry.Reason,
+		})
+		return apiErr
+	})
+	if err != nil {
+		metrics.SESAddTotal.WithLabelValues("error").Inc()
+		return Result{EmailAddress: entry.EmailAddress, Err: err}
+	}
+
+	c.upsert(entry)
+	metrics.SESAddTotal.WithLabelValues("success").Inc()
+	return Result{EmailAddress: entry.EmailAddress}
+}
+
+// upsert inserts or updates entry in the cache, keeping list sorted.
+func (c *Client) upsert(entry Entry) {
+	key := strings.ToLower(entry.EmailAddress)
+	c.mu.Lock()
+
+	if _, ok := c.index[key]; ok {
+		c.index[key] = entry
+		for i, e := range c.list {
+			if strings.ToLower(e.EmailAddress) == key {
+				c.list[i] = entry
+				break
+			}
+		}
+		c.mu.Unlock()
+		return
+	}
+
+	c.index[key] = entry
+	i := sort.Search(len(c.list), func(i int) bool {
+		return strings.ToLower(c.list[i].EmailAddress) >= key
+	})
+	c.list = append(c.list, Entry{})
+	copy(c.list[i+1:], c.list[i:])
+	c.list[i] = entry
+	size := len(c.list)
+	c.mu.Unlock()
+	metrics.SuppressionListSize.Set(float64(size))
+}
+
+// remove deletes key/addr from the cache. Callers must have already
+// deleted the entry from SES.
+func (c *Client) remove(key, addr string) {
+	c.mu.Lock()
+	delete(c.index, key)
+	for i, e := range c.list {
+		if e.EmailAddress == addr {
+			c.list = append(c.list[:i], c.list[i+1:]...)
+			break
+		}
+	}
+	size := len(c.list)
+	c.mu.Unlock()
+	metrics.SuppressionListSize.Set(float64(size))
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sesv2 "github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+)
+
+// fakeSES is an in-memory SESAPI used by tests.
+type fakeSES struct {
+	mu      sync.Mutex
+	entries map[string]types.SuppressedDestinationSummary
+	pages   [][]types.SuppressedDestinationSummary // pre-baked ListSuppressedDestinations pages
+
+	deleteErr error
+	putErr    error
+}
+
+func (f *fakeSES) ListSuppressedDestinations(ctx context.Context, params *sesv2.ListSuppressedDestinationsInput, optFns ...func(*sesv2.Options)) (*sesv2.ListSuppressedDestinationsOutput, error) {
+	idx := tokenToPage(params.NextToken)
+	if idx >= len(f.pages) {
+		return &sesv2.ListSuppressedDestinationsOutput{}, nil
+	}
+	out := &sesv2.ListSuppressedDestinationsOutput{
+		SuppressedDestinationSummaries: f.pages[idx],
+	}
+	if idx+1 < len(f.pages) {
+		out.NextToken = pageToToken(idx + 1)
+	}
+	return out, nil
+}
+
+func (f *fakeSES) DeleteSuppressedDestination(ctx context.Context, params *sesv2.DeleteSuppressedDestinationInput, optFns ...func(*sesv2.Options)) (*sesv2.DeleteSuppressedDestinationOutput, error) {
+	if f.deleteErr != nil {
+		return nil, f.deleteErr
+	}
+	f.mu.Lock()
+	delete(f.entries, aws.ToString(params.EmailAddress))
+	f.mu.Unlock()
+	return &sesv2.DeleteSuppressedDestinationOutput{}, nil
+}
+
+func (f *fakeSES) PutSuppressedDestination(ctx context.Context, params *sesv2.PutSuppressedDestinationInput, optFns ...func(*sesv2.Options)) (*sesv2.PutSuppressedDestinationOutput, error) {
+	if f.putErr != nil {
+		return nil, f.putErr
+	}
+	f.mu.Lock()
+	if f.entries == nil {
+		f.entries = make(map[string]types.SuppressedDestinationSummary)
+	}
+	f.entries[aws.ToString(params.EmailAddress)] = types.SuppressedDestinationSummary{
+		EmailAddress: params.EmailAddress,
+		Reason:       params.Reason,
+	}
+	f.mu.Unlock()
+	return &sesv2.PutSuppressedDestinationOutput{}, nil
+}
+
+func tokenToPage(token *string) int {
+	if token == nil {
+		return 0
+	}
+	switch *token {
+	case "page-1":
+		return 1
+	case "page-2":
+		return 2
+	}
+	return 0
+}
+
+func pageToToken(idx int) *string {
+	switch idx {
+	case 1:
+		return aws.String("page-1")
+	case 2:
+		return aws.String("page-2")
+	}
+	return nil
+}
+
+func TestRefreshPagination(t *testing.T) {
+	fake := &fakeSES{
+		pages: [][]types.SuppressedDestinationSummary{
+			{{EmailAddress: aws.String("b@example.com"), Reason: types.SuppressionListReasonBounce}},
+			{{EmailAddress: aws.String("a@example.com"), Reason: types.SuppressionListReasonComplaint}},
+		},
+	}
+	c := New(fake)
+
+	if err := c.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+
+	got, err := c.List(context.Background())
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(got))
+	}
+	if got[0].EmailAddress != "a@example.com" || got[1].EmailAddress != "b@example.com" {
+		t.Fatalf("expected case-insensitive sort, got %+v", got)
+	}
+}
+
+func TestRemoveConcurrent(t *testing.T) {
+	fake := &fakeSES{
+		pages: [][]types.SuppressedDestinationSummary{
+			{
+				{EmailAddress: aws.String("a@example.com"), Reason: types.SuppressionListReasonBounce},
+				{EmailAddress: aws.String("b@example.com"), Reason: types.SuppressionListReasonBounce},
+				{EmailAddress: aws.String("c@example.com"), Reason: types.SuppressionListReasonBounce},
+			},
+		},
+	}
+	c := New(fake)
+	if err := c.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	addrs := []string{"a@example.com", "b@example.com", "c@example.com"}
+	for _, addr := range addrs {
+		addr := addr
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Remove(context.Background(), []string{addr}); err != nil {
+				t.Errorf("Remove(%s) returned error: %v", addr, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	got, _ := c.List(context.Background())
+	if len(got) != 0 {
+		t.Fatalf("expected cache to be empty after concurrent removes, got %+v", got)
+	}
+}
+
+func TestRemoveNotFound(t *testing.T) {
+	c := New(&fakeSES{})
+	results, err := c.Remove(context.Background(), []string{"missing@example.com"})
+	if err != nil {
+		t.Fatalf("Remove returned error: %v", err)
+	}
+	if len(results) != 1 || results[0].Err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %+v", results)
+	}
+}
+
+func TestAddUpdatesCache(t *testing.T) {
+	fake := &fakeSES{}
+	c := New(fake)
+
+	results, err := c.Add(context.Background(), []Entry{
+		{EmailAddress: "new@example.com", Reason: types.SuppressionListReasonBounce},
+	})
+	if err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if results[0].Err != nil {
+		t.Fatalf("expected no error, got %v", results[0].Err)
+	}
+
+	entry, ok := c.Lookup(context.Background(), "NEW@example.com")
+	if !ok {
+		t.Fatal("expected new@example.com to be present after Add")
+	}
+	if entry.Reason != types.SuppressionListReasonBounce {
+		t.Fatalf("expected reason BOUNCE, got %v", entry.Reason)
+	}
+}
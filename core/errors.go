@@ -0,0 +1,7 @@
+package core
+
+import "errors"
+
+// ErrNotFound is returned by Remove when an address is not present in
+// the cached suppression list.
+var ErrNotFound = errors.New("core: address not found in suppression list")
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+const (
+	maxAttempts = 5
+	baseBackoff = 100 * time.Millisecond
+	maxBackoff  = 5 * time.Second
+)
+
+// withRetry runs fn, retrying with exponential backoff and jitter when fn
+// fails with an SES throttling error. It gives up early if ctx is done.
+func withRetry(ctx context.Context, fn func() error) error {
+	backoff := baseBackoff
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = fn()
+		if err == nil || !isThrottling(err) {
+			return err
+		}
+
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+	return err
+}
+
+// isThrottling reports whether err is an SES throttling error worth
+// retrying.
+func isThrottling(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "ThrottlingException", "TooManyRequestsException":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestWithRetrySucceedsAfterThrottling(t *testing.T) {
+	calls := 0
+	err := withRetry(context.Background(), func() error {
+		calls++
+		if calls < 3 {
+			return &smithy.GenericAPIError{Code: "ThrottlingException"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestWithRetryDoesNotRetryOtherErrors(t *testing.T) {
+	calls := 0
+	wantErr := errors.New("boom")
+	err := withRetry(context.Background(), func() error {
+		calls++
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("expected %v, got %v", wantErr, err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected no retries for a non-throttling error, got %d calls", calls)
+	}
+}
+
+func TestWithRetryRespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := withRetry(ctx, func() error {
+		return &smithy.GenericAPIError{Code: "ThrottlingException"}
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline exceeded, got %v", err)
+	}
+}
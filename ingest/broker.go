@@ -0,0 +1,54 @@
+package ingest
+
+import "sync"
+
+// Event is a single live suppression-list change, broadcast to SSE
+// subscribers as it's ingested from SQS.
+type Event struct {
+	Action string `json:"action"` // "add" or "remove"
+	Email  string `json:"email"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Broker fans Events out to any number of subscribers (one per
+// connected /events client).
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[chan Event]struct{}
+}
+
+// NewBroker returns an empty Broker.
+func NewBroker() *Broker {
+	return &Broker{subscribers: make(map[chan Event]struct{})}
+}
+
+// Subscribe registers a new subscriber and returns its channel and an
+// unsubscribe function the caller must call when done.
+func (b *Broker) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 16)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+	return ch, unsubscribe
+}
+
+// Publish broadcasts e to every current subscriber. A subscriber whose
+// buffer is full misses e rather than blocking the ingest loop; it stays
+// subscribed.
+func (b *Broker) Publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Package ingest replaces polling-based refresh with live suppression
+// updates: a goroutine long-polls an SQS queue fed by SES event
+// publishing (Bounce/Complaint), applies each change to the in-memory
+// cache, and broadcasts it to connected browsers over Server-Sent
+// Events.
+package ingest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/brookwarren/suppression-gui/audit"
+	"github.com/brookwarren/suppression-gui/core"
+)
+
+// processTimeout bounds how long a single already-received batch is
+// given to finish applying and deleting once Run's context is
+// cancelled, so draining can't hang shutdown forever.
+const processTimeout = 30 * time.Second
+
+// SQSAPI is the subset of the sqs client this package depends on. It
+// exists so tests can inject a fake client instead of hitting AWS.
+type SQSAPI interface {
+	ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error)
+	DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error)
+}
+
+// Suppressor is the subset of core.Client an Ingestor depends on.
+type Suppressor interface {
+	Add(ctx context.Context, entries []core.Entry) ([]core.Result, error)
+}
+
+// sesEvent is the SES event-publishing payload delivered to the queue.
+// Only the fields this package acts on are modeled.
+type sesEvent struct {
+	EventType string `json:"eventType"`
+	Mail      struct {
+		Destination []string `json:"destination"`
+	} `json:"mail"`
+}
+
+// Ingestor long-polls an SQS queue for SES bounce/complaint
+// notifications and applies them to core as they arrive.
+type Ingestor struct {
+	sqs               SQSAPI
+	queueURL          string
+	visibilityTimeout int32
+	core              Suppressor
+	broker            *Broker
+	audit             audit.Logger
+}
+
+// New returns an Ingestor for the given queue.
+func New(sqsClient SQSAPI, queueURL string, visibilityTimeout int32, core Suppressor, broker *Broker, auditLog audit.Logger) *Ingestor {
+	return &Ingestor{
+		sqs:               sqsClient,
+		queueURL:          queueURL,
+		visibilityTimeout: visibilityTimeout,
+		core:              core,
+		broker:            broker,
+		audit:             auditLog,
+	}
+}
+
+// Run long-polls the queue until ctx is done. A batch already received
+// when ctx is cancelled is still finished: processing switches to a
+// fresh, un-cancelled context (bounded by processTimeout) so a
+// SIGTERM-triggered shutdown doesn't drop in-flight messages that SQS
+// has already handed over.
+func (i *Ingestor) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		out, err := i.sqs.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(i.queueURL),
+			MaxNumberOfMessages: 10,
+			WaitTimeSeconds:     20,
+			VisibilityTimeout:   i.visibilityTimeout,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return // shutting down; ReceiveMessage was interrupted
+			}
+			slog.Error("ingest: receive failed", "action", "ingest_receive", "error", err)
+			continue
+		}
+
+		if len(out.Messages) == 0 {
+			continue
+		}
+
+		drainCtx, cancel := context.WithTimeout(context.Background(), processTimeout)
+		for _, msg := range out.Messages {
+			i.process(drainCtx, msg)
+		}
+		cancel()
+	}
+}
+
+func (i *Ingestor) process(ctx context.Context, msg sqstypes.Message) {
+	var evt sesEvent
+	if err := json.Unmarshal([]byte(aws.ToString(msg.Body)), &evt); err != nil {
+		slog.Error("ingest: failed to parse message", "action", "ingest_parse", "error", err)
+		return
+	}
+
+	var reason types.SuppressionListReason
+	switch evt.EventType {
+	case "Bounce":
+		reason = types.SuppressionListReasonBounce
+	case "Complaint":
+		reason = types.SuppressionListReasonComplaint
+	default:
+		i.delete(ctx, msg) // not a suppression-relevant event; ack and move on
+		return
+	}
+
+	entries := make([]core.Entry, len(evt.Mail.Destination))
+	for j, addr := range evt.Mail.Destination {
+		entries[j] = core.Entry{EmailAddress: addr, Reason: reason}
+	}
+	start := time.Now()
+	results, err := i.core.Add(ctx, entries)
+	if err != nil {
+		slog.Error("ingest: failed to apply event", "action", "ingest_apply", "latency_ms", time.Since(start).Milliseconds(), "error", err)
+		return
+	}
+
+	// Add never returns a top-level error for per-entry failures (they
+	// land in Result.Err), so each entry must be checked individually:
+	// only successes are broadcast as applied, and if any entry failed
+	// the message is left alone rather than deleted, so SQS redelivers
+	// it after the visibility timeout instead of the bounce/complaint
+	// being silently dropped.
+	failed := false
+	for _, res := range results {
+		entry := audit.Entry{Timestamp: time.Now(), User: "ingest", Action: "add", Email: res.EmailAddress, Reason: string(reason), Success: res.Err == nil}
+		if res.Err != nil {
+			failed = true
+			entry.Error = res.Err.Error()
+			slog.Error("ingest: failed to suppress destination", "action", "ingest_apply", "email", res.EmailAddress, "error", res.Err)
+		} else {
+			i.broker.Publish(Event{Action: "add", Email: res.EmailAddress, Reason: string(reason)})
+		}
+		if err := i.audit.Append(entry); err != nil {
+			slog.Error("ingest: audit append failed", "action", "ingest_apply", "email", res.EmailAddress, "error", err)
+		}
+	}
+	if failed {
+		return
+	}
+
+	i.delete(ctx, msg)
+}
+
+func (i *Ingestor) delete(ctx context.Context, msg sqstypes.Message) {
+	_, err := i.sqs.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(i.queueURL),
+		ReceiptHandle: msg.ReceiptHandle,
+	})
+	if err != nil {
+		slog.Error("ingest: failed to delete message", "action", "ingest_delete", "error", err)
+	}
+}
@@ -0,0 +1,228 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/brookwarren/suppression-gui/audit"
+	"github.com/brookwarren/suppression-gui/core"
+)
+
+// fakeAuditLogger records every appended entry.
+type fakeAuditLogger struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (f *fakeAuditLogger) Append(e audit.Entry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.entries = append(f.entries, e)
+	return nil
+}
+
+func (f *fakeAuditLogger) Query(q audit.Query) ([]audit.Entry, error) {
+	return nil, nil
+}
+
+// fakeSQS serves one batch of messages and then blocks (via ctx
+// cancellation) to simulate a long poll with nothing further to deliver.
+type fakeSQS struct {
+	mu       sync.Mutex
+	batches  [][]types.Message
+	deleted  []string
+	onDelete func()
+}
+
+func (f *fakeSQS) ReceiveMessage(ctx context.Context, params *sqs.ReceiveMessageInput, optFns ...func(*sqs.Options)) (*sqs.ReceiveMessageOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.batches) == 0 {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return &sqs.ReceiveMessageOutput{Messages: batch}, nil
+}
+
+func (f *fakeSQS) DeleteMessage(ctx context.Context, params *sqs.DeleteMessageInput, optFns ...func(*sqs.Options)) (*sqs.DeleteMessageOutput, error) {
+	f.mu.Lock()
+	f.deleted = append(f.deleted, aws.ToString(params.ReceiptHandle))
+	f.mu.Unlock()
+	if f.onDelete != nil {
+		f.onDelete()
+	}
+	return &sqs.DeleteMessageOutput{}, nil
+}
+
+// fakeSuppressor records every entry added. Addresses listed in failFor
+// come back with a non-nil Result.Err, simulating a PutSuppressedDestination
+// failure for that destination.
+type fakeSuppressor struct {
+	mu      sync.Mutex
+	entries []core.Entry
+	failFor map[string]bool
+}
+
+var errPutFailed = errors.New("put suppressed destination failed")
+
+func (f *fakeSuppressor) Add(ctx context.Context, entries []core.Entry) ([]core.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.entries = append(f.entries, entries...)
+	results := make([]core.Result, len(entries))
+	for i, e := range entries {
+		if f.failFor[e.EmailAddress] {
+			results[i] = core.Result{EmailAddress: e.EmailAddress, Err: errPutFailed}
+			continue
+		}
+		results[i] = core.Result{EmailAddress: e.EmailAddress}
+	}
+	return results, nil
+}
+
+func TestIngestorAppliesBounceEvent(t *testing.T) {
+	sqsFake := &fakeSQS{batches: [][]types.Message{
+		{{
+			Body:          aws.String(`{"eventType":"Bounce","mail":{"destination":["a@example.com"]}}`),
+			ReceiptHandle: aws.String("rh-1"),
+		}},
+	}}
+	suppressor := &fakeSuppressor{}
+	broker := NewBroker()
+	sub, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+	auditLog := &fakeAuditLogger{}
+
+	ing := New(sqsFake, "https://sqs.example/q", 30, suppressor, broker, auditLog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		ing.Run(ctx)
+		close(done)
+	}()
+
+	evt := <-sub
+	if evt.Email != "a@example.com" || evt.Action != "add" {
+		t.Fatalf("unexpected event: %+v", evt)
+	}
+
+	cancel()
+	<-done
+
+	suppressor.mu.Lock()
+	defer suppressor.mu.Unlock()
+	if len(suppressor.entries) != 1 || suppressor.entries[0].EmailAddress != "a@example.com" {
+		t.Fatalf("expected 1 entry added, got %+v", suppressor.entries)
+	}
+
+	sqsFake.mu.Lock()
+	defer sqsFake.mu.Unlock()
+	if len(sqsFake.deleted) != 1 || sqsFake.deleted[0] != "rh-1" {
+		t.Fatalf("expected message to be deleted, got %+v", sqsFake.deleted)
+	}
+
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	if len(auditLog.entries) != 1 || !auditLog.entries[0].Success || auditLog.entries[0].Email != "a@example.com" {
+		t.Fatalf("expected 1 successful audit entry, got %+v", auditLog.entries)
+	}
+}
+
+func TestIngestorIgnoresUnrelatedEventType(t *testing.T) {
+	sqsFake := &fakeSQS{batches: [][]types.Message{
+		{{
+			Body:          aws.String(`{"eventType":"Delivery","mail":{"destination":["a@example.com"]}}`),
+			ReceiptHandle: aws.String("rh-2"),
+		}},
+	}}
+	suppressor := &fakeSuppressor{}
+	ing := New(sqsFake, "https://sqs.example/q", 30, suppressor, NewBroker(), &fakeAuditLogger{})
+
+	processed := make(chan struct{}, 1)
+	sqsFake.onDelete = func() { processed <- struct{}{} }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		ing.Run(ctx)
+		close(done)
+	}()
+
+	<-processed
+	cancel()
+	<-done
+
+	suppressor.mu.Lock()
+	defer suppressor.mu.Unlock()
+	if len(suppressor.entries) != 0 {
+		t.Fatalf("expected no entries added for a non-suppression event, got %+v", suppressor.entries)
+	}
+}
+
+// TestIngestorLeavesMessageOnPartialFailure reproduces the scenario where
+// core.Client.Add reports a per-entry failure: the message must not be
+// deleted (so SQS redelivers it) and the failed address must not be
+// broadcast as applied, even though Add itself returns a nil error.
+func TestIngestorLeavesMessageOnPartialFailure(t *testing.T) {
+	sqsFake := &fakeSQS{batches: [][]types.Message{
+		{{
+			Body:          aws.String(`{"eventType":"Bounce","mail":{"destination":["a@example.com","b@example.com"]}}`),
+			ReceiptHandle: aws.String("rh-3"),
+		}},
+	}}
+	suppressor := &fakeSuppressor{failFor: map[string]bool{"b@example.com": true}}
+	broker := NewBroker()
+	sub, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+	auditLog := &fakeAuditLogger{}
+
+	ing := New(sqsFake, "https://sqs.example/q", 30, suppressor, broker, auditLog)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		ing.Run(ctx)
+		close(done)
+	}()
+
+	evt := <-sub
+	if evt.Email != "a@example.com" {
+		t.Fatalf("expected only the successful address to be broadcast, got %+v", evt)
+	}
+
+	select {
+	case evt := <-sub:
+		t.Fatalf("expected no event for the failed address, got %+v", evt)
+	default:
+	}
+
+	cancel()
+	<-done
+
+	sqsFake.mu.Lock()
+	defer sqsFake.mu.Unlock()
+	if len(sqsFake.deleted) != 0 {
+		t.Fatalf("expected message to be left for redelivery, got deleted %+v", sqsFake.deleted)
+	}
+
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	if len(auditLog.entries) != 2 {
+		t.Fatalf("expected an audit entry per destination, got %+v", auditLog.entries)
+	}
+	for _, e := range auditLog.entries {
+		wantSuccess := e.Email == "a@example.com"
+		if e.Success != wantSuccess {
+			t.Fatalf("unexpected audit entry: %+v", e)
+		}
+	}
+}
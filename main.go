@@ -2,26 +2,47 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
-	"log"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
 	"net/http"
 	"os"
-	"sort"
+	"os/signal"
+	"strconv"
 	"strings"
-	"sync"
+	"syscall"
+	"time"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	sesv2 "github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/brookwarren/suppression-gui/audit"
+	"github.com/brookwarren/suppression-gui/auth"
+	"github.com/brookwarren/suppression-gui/core"
+	"github.com/brookwarren/suppression-gui/ingest"
+	"github.com/brookwarren/suppression-gui/metrics"
+	"github.com/brookwarren/suppression-gui/syncer"
 )
 
-// Server holds application state and AWS client
+// Server wires HTTP routes to the core suppression-list client, session
+// store, audit log, and source syncer. It holds no AWS-specific state
+// itself.
 type Server struct {
-	client *sesv2.Client
-
-	listMu sync.RWMutex
-	list   []string          // sorted list of original‑case addresses
-	index  map[string]string // lowercase -> original case
+	core     *core.Client
+	sessions auth.Store
+	audit    audit.Logger
+	syncer   *syncer.Syncer
+	broker   *ingest.Broker
+	ingestor *ingest.Ingestor // nil if SQS_QUEUE_URL is not configured
 }
 
 func NewServer(ctx context.Context) (*Server, error) {
@@ -29,68 +50,141 @@ func NewServer(ctx context.Context) (*Server, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	sessions, err := auth.NewStoreFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	auditLog, err := audit.NewLoggerFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	coreClient := core.New(sesv2.NewFromConfig(cfg))
+
+	sourcesPath := os.Getenv("SOURCES_CONFIG_PATH")
+	if sourcesPath == "" {
+		sourcesPath = "sources.yaml"
+	}
+	syncCfg, err := syncer.LoadConfig(sourcesPath)
+	if err != nil {
+		slog.Warn("sources config not loaded", "path", sourcesPath, "error", err)
+	}
+
 	s := &Server{
-		client: sesv2.NewFromConfig(cfg),
-		index:  make(map[string]string),
+		core:     coreClient,
+		sessions: sessions,
+		audit:    auditLog,
+		syncer:   syncer.New(syncCfg, coreClient, auditLog),
+		broker:   ingest.NewBroker(),
+	}
+
+	if queueURL := os.Getenv("SQS_QUEUE_URL"); queueURL != "" {
+		visibilityTimeout, err := strconv.Atoi(os.Getenv("SQS_VISIBILITY_TIMEOUT"))
+		if err != nil || visibilityTimeout <= 0 {
+			visibilityTimeout = 30
+		}
+
+		sqsCfg := cfg
+		if region := os.Getenv("SQS_REGION"); region != "" {
+			sqsCfg, err = config.LoadDefaultConfig(ctx, config.WithRegion(region))
+			if err != nil {
+				return nil, err
+			}
+		}
+		s.ingestor = ingest.New(sqs.NewFromConfig(sqsCfg), queueURL, int32(visibilityTimeout), coreClient, s.broker, auditLog)
 	}
-	// Populate list on startup (non‑fatal if it fails)
-	if err := s.refresh(ctx); err != nil {
-		log.Printf("initial refresh failed: %v", err)
+
+	// Populate the cache on startup (non‑fatal if it fails)
+	start := time.Now()
+	if err := s.core.Refresh(ctx); err != nil {
+		slog.Error("initial refresh failed", "action", "refresh", "latency_ms", time.Since(start).Milliseconds(), "error", err)
+	} else {
+		slog.Info("initial refresh complete", "action", "refresh", "latency_ms", time.Since(start).Milliseconds())
 	}
 	return s, nil
 }
 
-// refresh queries AWS SES for *all* account-level suppressed addresses
-func (s *Server) refresh(ctx context.Context) error {
-	var (
-		emails []string
-		token  *string // pagination cursor
-	)
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(htmlPage))
+}
 
-	for {
-		out, err := s.client.ListSuppressedDestinations(
-			ctx,
-			&sesv2.ListSuppressedDestinationsInput{
-				NextToken: token,
-				PageSize:  aws.Int32(1000),
-			},
-		)
-		if err != nil {
-			return err
-		}
+// handleLogin serves the login form (GET) and authenticates against
+// ADMIN_PASSWORD (POST), issuing a session cookie and a CSRF cookie on
+// success.
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(loginPage))
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "GET or POST required", http.StatusMethodNotAllowed)
+		return
+	}
 
-		for _, d := range out.SuppressedDestinationSummaries {
-			emails = append(emails, aws.ToString(d.EmailAddress))
-		}
+	want := os.Getenv("ADMIN_PASSWORD")
+	if want == "" || subtle.ConstantTimeCompare([]byte(r.FormValue("password")), []byte(want)) != 1 {
+		http.Redirect(w, r, "/login?error=1", http.StatusFound)
+		return
+	}
 
-		if out.NextToken == nil { // no more pages
-			break
-		}
-		token = out.NextToken
+	sess, err := s.sessions.Create(r.Context(), "admin")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	csrfToken, err := auth.NewCSRFToken()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
-	// Case-insensitive sort A->Z
-	sort.Slice(emails, func(i, j int) bool {
-		return strings.ToLower(emails[i]) < strings.ToLower(emails[j])
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.CookieName,
+		Value:    sess.Token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   secureCookies(),
+		SameSite: http.SameSiteStrictMode,
+		Expires:  sess.ExpiresAt,
 	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.CSRFCookieName,
+		Value:    csrfToken,
+		Path:     "/",
+		HttpOnly: false, // the browser JS must read this to echo it back
+		Secure:   secureCookies(),
+		SameSite: http.SameSiteStrictMode,
+		Expires:  sess.ExpiresAt,
+	})
+	http.Redirect(w, r, "/", http.StatusFound)
+}
 
-	// Build quick-lookup map
-	idx := make(map[string]string, len(emails))
-	for _, e := range emails {
-		idx[strings.ToLower(e)] = e
+// secureCookies reports whether session and CSRF cookies should be
+// marked Secure (HTTPS-only). Defaults to true; set TLS_ENABLED=false
+// for local development over plain HTTP.
+func secureCookies() bool {
+	v := os.Getenv("TLS_ENABLED")
+	if v == "" {
+		return true
 	}
-
-	s.listMu.Lock()
-	s.list, s.index = emails, idx
-	s.listMu.Unlock()
-	return nil
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return true
+	}
+	return enabled
 }
 
 func (s *Server) handleList(w http.ResponseWriter, r *http.Request) {
-	s.listMu.RLock()
-	out := append([]string(nil), s.list...)
-	s.listMu.RUnlock()
-	respondJSON(w, out)
+	entries, err := s.core.List(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondJSON(w, entriesToEmails(entries))
 }
 
 func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) {
@@ -98,10 +192,14 @@ func (s *Server) handleUpdate(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "POST required", http.StatusMethodNotAllowed)
 		return
 	}
-	if err := s.refresh(r.Context()); err != nil {
+	user, _ := auth.UserFromContext(r.Context())
+	start := time.Now()
+	if err := s.core.Refresh(r.Context()); err != nil {
+		slog.Error("refresh failed", "request_id", requestIDFromContext(r.Context()), "user", user, "action", "refresh", "latency_ms", time.Since(start).Milliseconds(), "error", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	slog.Info("refresh complete", "request_id", requestIDFromContext(r.Context()), "user", user, "action", "refresh", "latency_ms", time.Since(start).Milliseconds())
 	s.handleList(w, r)
 }
 
@@ -133,70 +231,466 @@ func (s *Server) handleRemove(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var results []string
-	for _, input := range inputs {
-		key := strings.ToLower(input)
-		s.listMu.RLock()
-		orig, ok := s.index[key]
-		s.listMu.RUnlock()
-		if !ok {
-			results = append(results, "not found: "+input)
+	requestID := requestIDFromContext(r.Context())
+	user, _ := auth.UserFromContext(r.Context())
+	start := time.Now()
+	results, err := s.core.Remove(r.Context(), inputs)
+	if err != nil {
+		slog.Error("remove failed", "request_id", requestID, "user", user, "action", "remove", "latency_ms", time.Since(start).Milliseconds(), "error", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	out := make([]string, len(results))
+	for i, res := range results {
+		entry := audit.Entry{Timestamp: time.Now(), User: user, Action: "remove", Email: res.EmailAddress, Success: res.Err == nil}
+		switch {
+		case res.Err == core.ErrNotFound:
+			out[i] = "not found: " + res.EmailAddress
+			entry.Error = res.Err.Error()
+		case res.Err != nil:
+			out[i] = "error: " + res.EmailAddress + " (" + res.Err.Error() + ")"
+			entry.Error = res.Err.Error()
+		default:
+			out[i] = "removed: " + res.EmailAddress
+		}
+		if err := s.audit.Append(entry); err != nil {
+			slog.Error("audit append failed", "request_id", requestID, "user", user, "action", "remove", "email", res.EmailAddress, "error", err)
+		}
+	}
+	slog.Info("remove complete", "request_id", requestID, "user", user, "action", "remove", "count", len(results), "latency_ms", time.Since(start).Milliseconds())
+
+	respondJSON(w, removeResponse{Results: out})
+}
+
+// parseReason maps an import row's free-form reason column to the SES
+// SuppressionListReason enum. Unrecognized values default to BOUNCE, since
+// that is the more common suppression cause and the safer default for a
+// PutSuppressedDestination call.
+func parseReason(raw string) types.SuppressionListReason {
+	switch strings.ToUpper(strings.TrimSpace(raw)) {
+	case "COMPLAINT":
+		return types.SuppressionListReasonComplaint
+	default:
+		return types.SuppressionListReasonBounce
+	}
+}
+
+type importRow struct {
+	Email          string `json:"email"`
+	Reason         string `json:"reason"`
+	LastUpdateTime string `json:"last_update_time"`
+}
+
+type importResult struct {
+	Email   string `json:"email"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+	DryRun  bool   `json:"dry_run,omitempty"`
+}
+
+// handleImport bulk-loads suppression entries from a CSV or JSON body,
+// auto-detected via Content-Type, and calls core.Add for each row.
+// Results are streamed back as newline-delimited JSON so large imports
+// never need to be buffered in full, either on the way in or on the way
+// out.
+func (s *Server) handleImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST required", http.StatusMethodNotAllowed)
+		return
+	}
+	dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+	user, _ := auth.UserFromContext(r.Context())
+	requestID := requestIDFromContext(r.Context())
+
+	rows := make(chan importRow)
+	errc := make(chan error, 1)
+	// done is closed when the handler stops reading rows early (e.g. the
+	// client disconnects mid-stream and enc.Encode starts failing), so
+	// the producer goroutine's blocking `rows <- row` send has a second
+	// case to take instead of leaking forever with the request body
+	// still open.
+	done := make(chan struct{})
+	defer close(done)
+
+	ct, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch ct {
+	case "application/json":
+		go func() {
+			defer close(rows)
+			dec := json.NewDecoder(r.Body)
+			if _, err := dec.Token(); err != nil { // opening '['
+				errc <- err
+				return
+			}
+			for dec.More() {
+				var row importRow
+				if err := dec.Decode(&row); err != nil {
+					errc <- err
+					return
+				}
+				select {
+				case rows <- row:
+				case <-done:
+					return
+				}
+			}
+			errc <- nil
+		}()
+	default: // text/csv and anything else: assume CSV
+		go func() {
+			defer close(rows)
+			cr := csv.NewReader(r.Body)
+			cr.FieldsPerRecord = -1
+			for {
+				rec, err := cr.Read()
+				if err == io.EOF {
+					errc <- nil
+					return
+				}
+				if err != nil {
+					errc <- err
+					return
+				}
+				if len(rec) == 0 {
+					continue
+				}
+				row := importRow{Email: strings.TrimSpace(rec[0])}
+				if len(rec) > 1 {
+					row.Reason = strings.TrimSpace(rec[1])
+				}
+				if len(rec) > 2 {
+					row.LastUpdateTime = strings.TrimSpace(rec[2])
+				}
+				if strings.EqualFold(row.Email, "email") {
+					continue // skip a header row
+				}
+				select {
+				case rows <- row:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+
+	for row := range rows {
+		if row.Email == "" {
 			continue
 		}
-		// Remove from AWS
-		_, err := s.client.DeleteSuppressedDestination(r.Context(), &sesv2.DeleteSuppressedDestinationInput{
-			EmailAddress: aws.String(orig),
-		})
+		result := importResult{Email: row.Email, DryRun: dryRun}
+		entry := audit.Entry{Timestamp: time.Now(), User: user, Action: "import", Email: row.Email, Reason: row.Reason}
+		if dryRun {
+			result.Success = true
+		} else {
+			results, err := s.core.Add(r.Context(), []core.Entry{{
+				EmailAddress: row.Email,
+				Reason:       parseReason(row.Reason),
+			}})
+			if err != nil || results[0].Err != nil {
+				if err == nil {
+					err = results[0].Err
+				}
+				result.Error = err.Error()
+			} else {
+				result.Success = true
+			}
+		}
+		entry.Success = result.Success
+		entry.Error = result.Error
+		if !dryRun {
+			if err := s.audit.Append(entry); err != nil {
+				slog.Error("audit append failed", "request_id", requestID, "user", user, "action", "import", "email", row.Email, "error", err)
+			}
+		}
+		if err := enc.Encode(result); err != nil {
+			slog.Error("import write failed", "request_id", requestID, "user", user, "action", "import", "error", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if err := <-errc; err != nil {
+		enc.Encode(importResult{Error: fmt.Sprintf("parse error: %v", err)})
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// handleAudit serves GET /audit?from=...&to=...&email=..., where from/to
+// are RFC3339 timestamps.
+func (s *Server) handleAudit(w http.ResponseWriter, r *http.Request) {
+	q := audit.Query{Email: r.URL.Query().Get("email")}
+	if v := r.URL.Query().Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
 		if err != nil {
-			results = append(results, "error: "+input+" ("+err.Error()+")")
-			continue
+			http.Error(w, "invalid from: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		q.From = t
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "invalid to: "+err.Error(), http.StatusBadRequest)
+			return
 		}
-		// Update in‑memory cache
-		s.listMu.Lock()
-		delete(s.index, key)
-		for i, v := range s.list {
-			if v == orig {
-				s.list = append(s.list[:i], s.list[i+1:]...)
-				break
+		q.To = t
+	}
+
+	entries, err := s.audit.Query(q)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	respondJSON(w, entries)
+}
+
+// handleSources serves GET /sources, the last known sync status of
+// every configured source.
+func (s *Server) handleSources(w http.ResponseWriter, r *http.Request) {
+	respondJSON(w, s.syncer.Statuses())
+}
+
+// handleSourceSync serves POST /sources/{name}/sync, triggering an
+// immediate sync of a single source and returning its resulting status.
+func (s *Server) handleSourceSync(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if err := s.syncer.SyncOne(r.Context(), name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	for _, st := range s.syncer.Statuses() {
+		if st.Name == name {
+			respondJSON(w, st)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// handleEvents serves GET /events, a Server-Sent Events stream of live
+// suppression-list changes as they're ingested from SQS. It blocks for
+// the life of the connection.
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, unsubscribe := s.broker.Subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case evt := <-sub:
+			payload, err := json.Marshal(evt)
+			if err != nil {
+				continue
 			}
+			fmt.Fprintf(w, "data: %s\n\n", payload)
+			flusher.Flush()
 		}
-		s.listMu.Unlock()
-		results = append(results, "removed: "+orig)
 	}
+}
 
-	respondJSON(w, removeResponse{Results: results})
+func entriesToEmails(entries []core.Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.EmailAddress
+	}
+	return out
 }
 
 func respondJSON(w http.ResponseWriter, v interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	if err := json.NewEncoder(w).Encode(v); err != nil {
-		log.Println("json encode error:", err)
+		slog.Error("json encode failed", "error", err)
+	}
+}
+
+// statusRecorder captures the status code written by a handler so
+// loggingMiddleware can log it after the fact.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Flush forwards to the wrapped ResponseWriter so streaming handlers
+// (handleImport, handleEvents) can still flush through the recorder.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// requestIDKey is the context key under which requestIDMiddleware stores
+// the per-request ID so handlers and their logging can read it back.
+type requestIDKey struct{}
+
+// requestIDMiddleware generates a short random request ID and attaches it
+// to the request context, so every log line for a request can be
+// correlated.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := newRequestID()
+		ctx := context.WithValue(r.Context(), requestIDKey{}, id)
+		w.Header().Set("X-Request-Id", id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// requestIDFromContext returns the request ID attached by
+// requestIDMiddleware, or "" if none is present.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+func newRequestID() string {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "unknown"
 	}
+	return hex.EncodeToString(b[:])
+}
+
+// loggingMiddleware logs the method, path, status, and latency of every
+// request, and records http_requests_total and http_request_duration_seconds.
+func loggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		latency := time.Since(start)
+
+		status := strconv.Itoa(rec.status)
+		metrics.HTTPRequestsTotal.WithLabelValues(r.URL.Path, status).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(r.URL.Path).Observe(latency.Seconds())
+
+		slog.Info("request complete",
+			"request_id", requestIDFromContext(r.Context()),
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"latency_ms", latency.Milliseconds(),
+		)
+	})
 }
 
 func main() {
 	ctx := context.Background()
 	srv, err := NewServer(ctx)
 	if err != nil {
-		log.Fatalf("failed to start: %v", err)
+		slog.Error("failed to start", "error", err)
+		os.Exit(1)
 	}
 
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		w.Write([]byte(htmlPage))
-	})
-	http.HandleFunc("/list", srv.handleList)
-	http.HandleFunc("/update", srv.handleUpdate)
-	http.HandleFunc("/remove", srv.handleRemove)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", srv.handleLogin)
+	mux.Handle("/", auth.MiddlewareRedirect(srv.sessions, "/login", http.HandlerFunc(srv.handleIndex)))
+	mux.Handle("/list", auth.Middleware(srv.sessions, http.HandlerFunc(srv.handleList)))
+	mux.Handle("/update", auth.Middleware(srv.sessions, auth.CSRFMiddleware(http.HandlerFunc(srv.handleUpdate))))
+	mux.Handle("/remove", auth.Middleware(srv.sessions, auth.CSRFMiddleware(http.HandlerFunc(srv.handleRemove))))
+	mux.Handle("/import", auth.Middleware(srv.sessions, auth.CSRFMiddleware(http.HandlerFunc(srv.handleImport))))
+	mux.Handle("/audit", auth.Middleware(srv.sessions, http.HandlerFunc(srv.handleAudit)))
+	mux.Handle("/sources", auth.Middleware(srv.sessions, http.HandlerFunc(srv.handleSources)))
+	mux.Handle("POST /sources/{name}/sync", auth.Middleware(srv.sessions, auth.CSRFMiddleware(http.HandlerFunc(srv.handleSourceSync))))
+	mux.Handle("/events", auth.Middleware(srv.sessions, http.HandlerFunc(srv.handleEvents)))
+	mux.Handle("/metrics", promhttp.Handler())
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM, os.Interrupt)
+	defer stop()
+
+	go srv.syncer.Run(ctx)
+	ingestDone := make(chan struct{})
+	if srv.ingestor != nil {
+		go func() {
+			defer close(ingestDone)
+			srv.ingestor.Run(ctx)
+		}()
+	} else {
+		close(ingestDone)
+	}
 
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
-	log.Printf("Listening on :%s", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	httpSrv := &http.Server{Addr: ":" + port, Handler: requestIDMiddleware(loggingMiddleware(mux))}
+
+	shutdownDone := make(chan struct{})
+	go func() {
+		defer close(shutdownDone)
+		<-ctx.Done()
+		slog.Info("shutting down: draining in-flight requests")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+			slog.Error("shutdown error", "error", err)
+		}
+	}()
+
+	slog.Info("listening", "port", port)
+	if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		slog.Error("server error", "error", err)
+		os.Exit(1)
+	}
+	// ListenAndServe returns as soon as Shutdown begins; wait for the
+	// HTTP drain and the ingestor's in-flight batch before exiting.
+	<-shutdownDone
+	<-ingestDone
 }
 
+const loginPage = `<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <title>Log in — SES Suppression List Manager</title>
+    <style>
+        body { font-family: Arial, sans-serif; margin: 40px; }
+        input { display: block; margin-bottom: 8px; padding: 6px; }
+        button { padding: 6px 16px; }
+        .error { color: #b00020; }
+    </style>
+</head>
+<body>
+    <h2>Log in</h2>
+    <div id="error" class="error"></div>
+    <form method="POST" action="/login">
+        <input type="password" name="password" placeholder="Password" autofocus>
+        <button type="submit">Log in</button>
+    </form>
+    <script>
+    if (new URLSearchParams(window.location.search).get('error')) {
+        document.getElementById('error').textContent = 'Invalid password';
+    }
+    </script>
+</body>
+</html>`
+
 const htmlPage = `<!DOCTYPE html>
 <html>
 <head>
@@ -218,14 +712,41 @@ const htmlPage = `<!DOCTYPE html>
     <textarea id="removeInput" rows="10" placeholder="one address per line"></textarea><br>
     <button id="removeBtn">Remove</button>
 
+    <h2>Import</h2>
+    <p>Paste CSV (<code>email,reason,last_update_time</code>) or a JSON array, or choose a file. Reason is <code>BOUNCE</code> or <code>COMPLAINT</code>.</p>
+    <textarea id="importInput" rows="10" placeholder="email,reason,last_update_time"></textarea><br>
+    <input type="file" id="importFile"><br>
+    <label><input type="checkbox" id="importDryRun"> Dry run</label><br>
+    <button id="importBtn">Import</button>
+    <pre id="importOutput" style="max-width: 600px; max-height: 300px; overflow: auto; background: #f4f4f4; padding: 8px;"></pre>
+
+    <h2>History</h2>
+    <label>Email filter: <input type="text" id="historyEmail" placeholder="optional"></label>
+    <button id="historyBtn">Load history</button>
+    <pre id="historyOutput" style="max-width: 600px; max-height: 300px; overflow: auto; background: #f4f4f4; padding: 8px;"></pre>
+
+    <h2>Sources</h2>
+    <button id="sourcesBtn">Refresh status</button>
+    <table id="sourcesTable" border="1" cellpadding="4" style="border-collapse: collapse; margin-top: 8px;">
+        <thead><tr><th>Name</th><th>Last sync</th><th>Added</th><th>Removed</th><th>Error</th><th></th></tr></thead>
+        <tbody></tbody>
+    </table>
+
     <script>
+    function getCookie(name) {
+        const match = document.cookie.match(new RegExp('(?:^|; )' + name + '=([^;]*)'));
+        return match ? match[1] : '';
+    }
+    function csrfHeaders(extra) {
+        return Object.assign({'X-CSRF-Token': getCookie('suppression_csrf')}, extra || {});
+    }
     async function loadList() {
         const res = await fetch('/list');
         const data = await res.json();
         document.getElementById('suppressionList').value = data.join('\n');
     }
     document.getElementById('updateBtn').onclick = async () => {
-        const res = await fetch('/update', {method: 'POST'});
+        const res = await fetch('/update', {method: 'POST', headers: csrfHeaders()});
         const data = await res.json();
         document.getElementById('suppressionList').value = data.join('\n');
     };
@@ -233,14 +754,87 @@ const htmlPage = `<!DOCTYPE html>
         const input = document.getElementById('removeInput').value;
         const res = await fetch('/remove', {
             method: 'POST',
-            headers: {'Content-Type': 'application/json'},
+            headers: csrfHeaders({'Content-Type': 'application/json'}),
             body: JSON.stringify({emails: input})
         });
         const data = await res.json();
         document.getElementById('removeInput').value = data.results.join('\n');
         await loadList();
     };
-    window.onload = loadList;
+    document.getElementById('importBtn').onclick = async () => {
+        const file = document.getElementById('importFile').files[0];
+        const pasted = document.getElementById('importInput').value;
+        const body = file ? await file.text() : pasted;
+        const isJSON = body.trim().startsWith('[');
+        const dryRun = document.getElementById('importDryRun').checked;
+        const output = document.getElementById('importOutput');
+        output.textContent = '';
+
+        const res = await fetch('/import?dry_run=' + dryRun, {
+            method: 'POST',
+            headers: csrfHeaders({'Content-Type': isJSON ? 'application/json' : 'text/csv'}),
+            body: body
+        });
+        const reader = res.body.getReader();
+        const decoder = new TextDecoder();
+        let buf = '';
+        while (true) {
+            const {done, value} = await reader.read();
+            if (done) break;
+            buf += decoder.decode(value, {stream: true});
+            let lines = buf.split('\n');
+            buf = lines.pop();
+            for (const line of lines) {
+                if (line) output.textContent += line + '\n';
+            }
+        }
+        await loadList();
+    };
+    document.getElementById('historyBtn').onclick = async () => {
+        const email = document.getElementById('historyEmail').value;
+        const params = email ? '?email=' + encodeURIComponent(email) : '';
+        const res = await fetch('/audit' + params);
+        const data = await res.json();
+        document.getElementById('historyOutput').textContent = JSON.stringify(data, null, 2);
+    };
+    async function loadSources() {
+        const res = await fetch('/sources');
+        const data = await res.json();
+        const tbody = document.querySelector('#sourcesTable tbody');
+        tbody.innerHTML = '';
+        for (const s of data) {
+            const tr = document.createElement('tr');
+            [s.Name, s.LastSyncAt || '', s.Added || 0, s.Removed || 0, s.LastError || ''].forEach(text => {
+                const td = document.createElement('td');
+                td.textContent = text;
+                tr.appendChild(td);
+            });
+            const actions = document.createElement('td');
+            const btn = document.createElement('button');
+            btn.dataset.name = s.Name;
+            btn.textContent = 'Sync now';
+            actions.appendChild(btn);
+            tr.appendChild(actions);
+            tbody.appendChild(tr);
+        }
+        tbody.querySelectorAll('button').forEach(btn => {
+            btn.onclick = async () => {
+                await fetch('/sources/' + encodeURIComponent(btn.dataset.name) + '/sync', {
+                    method: 'POST',
+                    headers: csrfHeaders()
+                });
+                await loadSources();
+                await loadList();
+            };
+        });
+    }
+    document.getElementById('sourcesBtn').onclick = loadSources;
+    function watchEvents() {
+        const es = new EventSource('/events');
+        es.onmessage = () => { loadList(); };
+        es.onerror = () => { es.close(); setTimeout(watchEvents, 5000); };
+    }
+    window.onload = () => { loadList(); loadSources(); watchEvents(); };
     </script>
 </body>
 </html>`
@@ -0,0 +1,69 @@
+// Package metrics defines the Prometheus collectors this service
+// exposes on /metrics, so core, the syncer, and HTTP handlers all
+// record observations through the same registry.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// SESListDuration observes how long each SES ListSuppressedDestinations
+	// page fetch takes.
+	SESListDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "ses_list_duration_seconds",
+		Help: "Duration of SES ListSuppressedDestinations calls.",
+	})
+
+	// SESDeleteTotal counts DeleteSuppressedDestination attempts by
+	// outcome ("success" or "error").
+	SESDeleteTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ses_delete_total",
+		Help: "Total SES suppression deletes, by result.",
+	}, []string{"result"})
+
+	// SESAddTotal counts PutSuppressedDestination attempts by outcome
+	// ("success" or "error").
+	SESAddTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ses_add_total",
+		Help: "Total SES suppression adds, by result.",
+	}, []string{"result"})
+
+	// SESListEntries records the number of entries returned by the most
+	// recent full Refresh.
+	SESListEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ses_list_entries",
+		Help: "Number of suppression entries fetched by the most recent refresh.",
+	})
+
+	// SuppressionListSize is the current size of the in-memory
+	// suppression-list cache.
+	SuppressionListSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "suppression_list_size",
+		Help: "Current number of entries in the suppression-list cache.",
+	})
+
+	// HTTPRequestsTotal counts every request handled, by path and status.
+	HTTPRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests, by path and status code.",
+	}, []string{"path", "status"})
+
+	// HTTPRequestDuration observes handler latency by path.
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "http_request_duration_seconds",
+		Help: "HTTP request latency, by path.",
+	}, []string{"path"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		SESListDuration,
+		SESDeleteTotal,
+		SESAddTotal,
+		SESListEntries,
+		SuppressionListSize,
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+	)
+}
@@ -0,0 +1,59 @@
+package syncer
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Source is one externally-hosted suppression feed.
+type Source struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+	// Format is "json" (an array of {email, reason}) or "csv"
+	// (email,reason per line). Defaults to "json".
+	Format string `yaml:"format"`
+	// AuthHeader/AuthToken, if both set, are sent as a request header
+	// on every fetch (e.g. AuthHeader "Authorization", AuthToken
+	// "Bearer ...").
+	AuthHeader string `yaml:"auth_header"`
+	AuthToken  string `yaml:"auth_token"`
+	// Authoritative sources also cause addresses that disappear from
+	// the feed to be removed from SES.
+	Authoritative bool `yaml:"authoritative"`
+}
+
+// Config is the top-level shape of sources.yaml.
+type Config struct {
+	// Interval is how often every source is polled, e.g. "15m".
+	// Defaults to 15m.
+	Interval string   `yaml:"interval"`
+	Sources  []Source `yaml:"sources"`
+}
+
+// PollInterval parses Interval, defaulting to 15 minutes.
+func (c Config) PollInterval() time.Duration {
+	if c.Interval == "" {
+		return 15 * time.Minute
+	}
+	d, err := time.ParseDuration(c.Interval)
+	if err != nil {
+		return 15 * time.Minute
+	}
+	return d
+}
+
+// LoadConfig reads and parses a sources.yaml file.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("syncer: parsing %s: %w", path, err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,89 @@
+package syncer
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sesv2/types"
+)
+
+// feedRow is one row of a source feed, in either JSON or CSV form.
+type feedRow struct {
+	Email  string `json:"email"`
+	Reason string `json:"reason"`
+}
+
+// fetch retrieves and parses src's feed.
+func fetch(ctx context.Context, client *http.Client, src Source) ([]feedRow, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if src.AuthHeader != "" && src.AuthToken != "" {
+		req.Header.Set(src.AuthHeader, src.AuthToken)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("syncer: %s returned %s", src.Name, resp.Status)
+	}
+
+	if src.Format == "csv" {
+		return parseCSV(resp.Body)
+	}
+	return parseJSON(resp.Body)
+}
+
+func parseJSON(r io.Reader) ([]feedRow, error) {
+	var rows []feedRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func parseCSV(r io.Reader) ([]feedRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	var rows []feedRow
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rec) == 0 {
+			continue
+		}
+		email := strings.TrimSpace(rec[0])
+		if email == "" || strings.EqualFold(email, "email") {
+			continue
+		}
+		row := feedRow{Email: email}
+		if len(rec) > 1 {
+			row.Reason = strings.TrimSpace(rec[1])
+		}
+		rows = append(rows, row)
+	}
+}
+
+// reason maps a feed row's free-form reason to the SES enum, defaulting
+// to BOUNCE for unrecognized values.
+func reason(raw string) types.SuppressionListReason {
+	if strings.EqualFold(strings.TrimSpace(raw), "COMPLAINT") {
+		return types.SuppressionListReasonComplaint
+	}
+	return types.SuppressionListReasonBounce
+}
@@ -0,0 +1,226 @@
+// Package syncer reconciles SES suppressions against external
+// suppression-source feeds (a bounce aggregator, a complaint webhook
+// mirror, etc.) on a schedule.
+package syncer
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brookwarren/suppression-gui/audit"
+	"github.com/brookwarren/suppression-gui/core"
+)
+
+// Suppressor is the subset of core.Client a Syncer depends on. Tests can
+// inject a fake instead of a real *core.Client.
+type Suppressor interface {
+	List(ctx context.Context) ([]core.Entry, error)
+	Add(ctx context.Context, entries []core.Entry) ([]core.Result, error)
+	Remove(ctx context.Context, addrs []string) ([]core.Result, error)
+}
+
+// Status reports the outcome of the most recent sync of a single source.
+type Status struct {
+	Name       string
+	LastSyncAt time.Time
+	LastError  string
+	Added      int
+	Removed    int
+}
+
+// Syncer periodically polls every configured Source and reconciles it
+// against SES via a Suppressor.
+type Syncer struct {
+	cfg    Config
+	core   Suppressor
+	audit  audit.Logger
+	client *http.Client
+
+	mu       sync.Mutex
+	status   map[string]Status
+	lastSeen map[string]map[string]bool // source name -> lowercase emails seen last sync
+}
+
+// New returns a Syncer for cfg's sources, auditing every add/remove it
+// applies under auditLog.
+func New(cfg Config, core Suppressor, auditLog audit.Logger) *Syncer {
+	return &Syncer{
+		cfg:      cfg,
+		core:     core,
+		audit:    auditLog,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		status:   make(map[string]Status),
+		lastSeen: make(map[string]map[string]bool),
+	}
+}
+
+// Run polls every source on cfg.PollInterval() until ctx is done.
+func (s *Syncer) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.PollInterval())
+	defer ticker.Stop()
+
+	s.SyncAll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.SyncAll(ctx)
+		}
+	}
+}
+
+// SyncAll syncs every configured source.
+func (s *Syncer) SyncAll(ctx context.Context) {
+	for _, src := range s.cfg.Sources {
+		start := time.Now()
+		if err := s.SyncOne(ctx, src.Name); err != nil {
+			slog.Error("syncer: sync failed", "action", "sync", "source", src.Name, "latency_ms", time.Since(start).Milliseconds(), "error", err)
+		}
+	}
+}
+
+// SyncOne fetches a single named source and reconciles it against SES,
+// diffing the feed against the suppression list's current state so only
+// rows that are new or whose reason changed are added, instead of
+// reissuing Add for the whole feed on every poll.
+func (s *Syncer) SyncOne(ctx context.Context, name string) error {
+	src, ok := s.source(name)
+	if !ok {
+		return errUnknownSource(name)
+	}
+
+	rows, err := fetch(ctx, s.client, src)
+	if err != nil {
+		s.recordStatus(Status{Name: name, LastSyncAt: time.Now(), LastError: err.Error()})
+		return err
+	}
+
+	current, err := s.core.List(ctx)
+	if err != nil {
+		s.recordStatus(Status{Name: name, LastSyncAt: time.Now(), LastError: err.Error()})
+		return err
+	}
+	existing := make(map[string]core.Entry, len(current))
+	for _, e := range current {
+		existing[strings.ToLower(e.EmailAddress)] = e
+	}
+
+	seen := make(map[string]bool, len(rows))
+	var toAdd []core.Entry
+	for _, row := range rows {
+		key := strings.ToLower(row.Email)
+		seen[key] = true
+		r := reason(row.Reason)
+		if prev, ok := existing[key]; !ok || prev.Reason != r {
+			toAdd = append(toAdd, core.Entry{EmailAddress: row.Email, Reason: r})
+		}
+	}
+
+	added := 0
+	if len(toAdd) > 0 {
+		results, err := s.core.Add(ctx, toAdd)
+		if err != nil {
+			s.recordStatus(Status{Name: name, LastSyncAt: time.Now(), LastError: err.Error()})
+			return err
+		}
+		for i, r := range results {
+			if r.Err == nil {
+				added++
+			}
+			s.appendAudit(name, "add", r, string(toAdd[i].Reason))
+		}
+	}
+
+	removed := 0
+	if src.Authoritative {
+		s.mu.Lock()
+		previouslySeen := s.lastSeen[name]
+		s.mu.Unlock()
+
+		var toRemove []string
+		for key := range previouslySeen {
+			if !seen[key] {
+				toRemove = append(toRemove, key)
+			}
+		}
+		if len(toRemove) > 0 {
+			results, err := s.core.Remove(ctx, toRemove)
+			if err != nil {
+				s.recordStatus(Status{Name: name, LastSyncAt: time.Now(), LastError: err.Error()})
+				return err
+			}
+			for _, r := range results {
+				if r.Err == nil {
+					removed++
+				}
+				s.appendAudit(name, "remove", r, "")
+			}
+		}
+	}
+
+	s.mu.Lock()
+	s.lastSeen[name] = seen
+	s.mu.Unlock()
+
+	s.recordStatus(Status{Name: name, LastSyncAt: time.Now(), Added: added, Removed: removed})
+	return nil
+}
+
+// Statuses returns the last known sync status for every configured source.
+func (s *Syncer) Statuses() []Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]Status, 0, len(s.cfg.Sources))
+	for _, src := range s.cfg.Sources {
+		if st, ok := s.status[src.Name]; ok {
+			out = append(out, st)
+		} else {
+			out = append(out, Status{Name: src.Name})
+		}
+	}
+	return out
+}
+
+func (s *Syncer) source(name string) (Source, bool) {
+	for _, src := range s.cfg.Sources {
+		if src.Name == name {
+			return src, true
+		}
+	}
+	return Source{}, false
+}
+
+func (s *Syncer) recordStatus(st Status) {
+	s.mu.Lock()
+	s.status[st.Name] = st
+	s.mu.Unlock()
+}
+
+// appendAudit records a single add/remove applied on behalf of source
+// name so automated syncer writes show up in the audit trail alongside
+// user-triggered ones.
+func (s *Syncer) appendAudit(name, action string, r core.Result, reason string) {
+	entry := audit.Entry{
+		Timestamp: time.Now(),
+		User:      "syncer:" + name,
+		Action:    action,
+		Email:     r.EmailAddress,
+		Reason:    reason,
+		Success:   r.Err == nil,
+	}
+	if r.Err != nil {
+		entry.Error = r.Err.Error()
+	}
+	if err := s.audit.Append(entry); err != nil {
+		slog.Error("syncer: audit append failed", "action", "sync", "source", name, "email", r.EmailAddress, "error", err)
+	}
+}
+
+type errUnknownSource string
+
+func (e errUnknownSource) Error() string { return "syncer: unknown source " + string(e) }
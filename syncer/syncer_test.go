@@ -0,0 +1,149 @@
+package syncer
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/brookwarren/suppression-gui/audit"
+	"github.com/brookwarren/suppression-gui/core"
+)
+
+// fakeAuditLogger records every appended entry.
+type fakeAuditLogger struct {
+	mu      sync.Mutex
+	entries []audit.Entry
+}
+
+func (f *fakeAuditLogger) Append(e audit.Entry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.entries = append(f.entries, e)
+	return nil
+}
+
+func (f *fakeAuditLogger) Query(q audit.Query) ([]audit.Entry, error) {
+	return nil, nil
+}
+
+// fakeSuppressor is an in-memory Suppressor used by tests.
+type fakeSuppressor struct {
+	mu      sync.Mutex
+	entries map[string]core.Entry
+}
+
+func newFakeSuppressor() *fakeSuppressor {
+	return &fakeSuppressor{entries: make(map[string]core.Entry)}
+}
+
+func (f *fakeSuppressor) List(ctx context.Context) ([]core.Entry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]core.Entry, 0, len(f.entries))
+	for _, e := range f.entries {
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func (f *fakeSuppressor) Add(ctx context.Context, entries []core.Entry) ([]core.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	results := make([]core.Result, len(entries))
+	for i, e := range entries {
+		f.entries[e.EmailAddress] = e
+		results[i] = core.Result{EmailAddress: e.EmailAddress}
+	}
+	return results, nil
+}
+
+func (f *fakeSuppressor) Remove(ctx context.Context, addrs []string) ([]core.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	results := make([]core.Result, len(addrs))
+	for i, addr := range addrs {
+		delete(f.entries, addr)
+		results[i] = core.Result{EmailAddress: addr}
+	}
+	return results, nil
+}
+
+func TestSyncOneAddsEntries(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"email":"a@example.com","reason":"BOUNCE"},{"email":"b@example.com","reason":"COMPLAINT"}]`))
+	}))
+	defer ts.Close()
+
+	suppressor := newFakeSuppressor()
+	auditLog := &fakeAuditLogger{}
+	cfg := Config{Sources: []Source{{Name: "feed", URL: ts.URL, Format: "json"}}}
+	s := New(cfg, suppressor, auditLog)
+
+	if err := s.SyncOne(context.Background(), "feed"); err != nil {
+		t.Fatalf("SyncOne returned error: %v", err)
+	}
+
+	entries, _ := suppressor.List(context.Background())
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	statuses := s.Statuses()
+	if len(statuses) != 1 || statuses[0].Added != 2 {
+		t.Fatalf("expected status with 2 added, got %+v", statuses)
+	}
+
+	auditLog.mu.Lock()
+	defer auditLog.mu.Unlock()
+	if len(auditLog.entries) != 2 {
+		t.Fatalf("expected 2 audit entries, got %+v", auditLog.entries)
+	}
+	for _, e := range auditLog.entries {
+		if e.User != "syncer:feed" || e.Action != "add" || !e.Success {
+			t.Fatalf("unexpected audit entry: %+v", e)
+		}
+	}
+}
+
+func TestSyncOneAuthoritativeRemovesMissing(t *testing.T) {
+	round := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		round++
+		if round == 1 {
+			w.Write([]byte(`[{"email":"a@example.com","reason":"BOUNCE"},{"email":"b@example.com","reason":"BOUNCE"}]`))
+		} else {
+			w.Write([]byte(`[{"email":"a@example.com","reason":"BOUNCE"}]`))
+		}
+	}))
+	defer ts.Close()
+
+	suppressor := newFakeSuppressor()
+	cfg := Config{Sources: []Source{{Name: "feed", URL: ts.URL, Format: "json", Authoritative: true}}}
+	s := New(cfg, suppressor, &fakeAuditLogger{})
+
+	if err := s.SyncOne(context.Background(), "feed"); err != nil {
+		t.Fatalf("first SyncOne returned error: %v", err)
+	}
+	if err := s.SyncOne(context.Background(), "feed"); err != nil {
+		t.Fatalf("second SyncOne returned error: %v", err)
+	}
+
+	entries, _ := suppressor.List(context.Background())
+	if len(entries) != 1 {
+		t.Fatalf("expected b@example.com to be removed, got %+v", entries)
+	}
+
+	statuses := s.Statuses()
+	if statuses[0].Removed != 1 {
+		t.Fatalf("expected 1 removed, got %+v", statuses)
+	}
+}
+
+func TestSyncOneUnknownSource(t *testing.T) {
+	s := New(Config{}, newFakeSuppressor(), &fakeAuditLogger{})
+	if err := s.SyncOne(context.Background(), "missing"); err == nil {
+		t.Fatal("expected error for unknown source")
+	}
+}